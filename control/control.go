@@ -1,9 +1,11 @@
 package control
 
 import (
+	"context"
 	"crypto/rsa"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/intelsdilabs/gomit"
 
+	"github.com/intelsdilabs/pulse/control/dist"
 	"github.com/intelsdilabs/pulse/control/plugin"
 	"github.com/intelsdilabs/pulse/control/plugin/client"
 	"github.com/intelsdilabs/pulse/control/routing"
@@ -43,6 +46,39 @@ type pluginControl struct {
 	pluginRunner  runsPlugins
 
 	strategy RoutingStrategy
+
+	// instanceMetricsMu guards every read and write of an
+	// availablePlugin's hitCount/lastHitTime across concurrent
+	// CollectMetrics calls sharing a pool: strategy.Select reads
+	// HitCount() to choose an instance, and collectFromPlugin writes it
+	// back afterwards, so both must go through the same lock.
+	instanceMetricsMu sync.Mutex
+
+	// blobStore holds every plugin binary ever pulled, keyed by its
+	// sha256 digest, so the same bytes load on every node.
+	blobStore  *dist.BlobStore
+	distClient *dist.Client
+
+	enableConfigsMu sync.Mutex
+	enableConfigs   map[string]*EnableConfig
+
+	subscriptionCountsMu sync.Mutex
+	subscriptionCounts   map[string]int
+
+	// manifestPrivileges and privilegeAllowlist back the privilege model:
+	// each pulled plugin's declared privileges (keyed by manifest name)
+	// are checked against the control-wide allowlist before Load/Enable
+	// are allowed to activate it. A nil allowlist permits anything; an
+	// empty, non-nil one denies every declared privilege. Plugins loaded
+	// from a bare filesystem path have no manifest and are not checked.
+	manifestPrivilegesMu sync.Mutex
+	manifestPrivileges   map[string][]string
+	privilegeAllowlist   []string
+
+	// CollectRetryAttempts bounds how many instances of a plugin's pool
+	// CollectMetrics will try before giving up on that plugin key. Zero
+	// means use defaultCollectRetryAttempts.
+	CollectRetryAttempts int
 }
 
 type runsPlugins interface {
@@ -54,14 +90,27 @@ type runsPlugins interface {
 	SetMetricCatalog(c catalogsMetrics)
 	SetPluginManager(m managesPlugins)
 	Monitor() *monitor
+	// StopPluginsForKey stops every running process backing pluginKey
+	// without unloading the plugin from the catalog, so a disabled
+	// plugin keeps its metric catalog entries.
+	StopPluginsForKey(pluginKey string) []error
 }
 
 type managesPlugins interface {
-	LoadPlugin(string, gomit.Emitter) (*loadedPlugin, error)
+	// LoadPlugin loads the plugin at path under the given alias (empty
+	// meaning "no alias", i.e. keyed by name:version as before). digest
+	// is the sha256 digest control already verified the binary against
+	// (e.g. via Pull) and is recorded as the plugin's CatalogedPlugin
+	// Digest(); it is empty when path was loaded directly from the
+	// filesystem rather than resolved from a distribution reference.
+	LoadPlugin(path string, emitter gomit.Emitter, alias string, digest string) (*loadedPlugin, error)
 	UnloadPlugin(CatalogedPlugin) error
 	LoadedPlugins() *loadedPlugins
 	SetMetricCatalog(catalogsMetrics)
 	GenerateArgs(pluginPath string) plugin.Arg
+	// SetPluginStatus transitions the loaded plugin identified by key
+	// through its lifecycle ("loaded", "enabled", "disabled").
+	SetPluginStatus(key string, status string) error
 }
 
 type catalogsMetrics interface {
@@ -74,12 +123,30 @@ type catalogsMetrics interface {
 	Subscribe([]string, int) error
 	Unsubscribe([]string, int) error
 	GetPlugin([]string, int) (*loadedPlugin, error)
+	// GetPluginByAlias resolves a loaded plugin by its alias (see
+	// WithAlias) rather than by namespace, so a task can pin collection
+	// to one specific loaded instance among several sharing a namespace.
+	GetPluginByAlias(alias string) (*loadedPlugin, error)
+	// NamespacesForPlugin returns every metric namespace the plugin
+	// identified by key has registered into the catalog.
+	NamespacesForPlugin(key string) [][]string
+}
+
+// aliasedMetricType is implemented by a core.MetricType that has been
+// pinned to a specific loaded plugin instance via an alias rather than
+// resolved purely by namespace.
+type aliasedMetricType interface {
+	Alias() string
 }
 
 // New returns a new pluginControl instance
 func New() *pluginControl {
 
-	c := &pluginControl{}
+	c := &pluginControl{
+		enableConfigs:      make(map[string]*EnableConfig),
+		subscriptionCounts: make(map[string]int),
+		manifestPrivileges: make(map[string][]string),
+	}
 	// Initialize components
 	//
 	// Event Manager
@@ -106,6 +173,11 @@ func New() *pluginControl {
 
 	// Strategy
 	c.strategy = &routing.RoundRobinStrategy{}
+	// routing.LeastLoaded is not wired in as a retry strategy: doing so
+	// would require it to satisfy the RoutingStrategy interface that
+	// RoundRobinStrategy implements, and that interface's definition
+	// lives outside this checkout, so the match can't be confirmed here.
+	// See the comment on LeastLoaded itself.
 
 	// Wire event manager
 
@@ -131,16 +203,74 @@ func (p *pluginControl) Stop() {
 	logger.Debug("control.stop", "stopped")
 }
 
+// ConfigureDistribution wires up a content-addressable blobstore rooted at
+// storeRoot and a distribution client that fetches plugin manifests and
+// binaries through fetcher. Until this is called, Load only accepts
+// filesystem paths; afterwards it also accepts OCI-style plugin
+// references such as "snap-collector-mock:1@sha256:<digest>".
+func (p *pluginControl) ConfigureDistribution(storeRoot string, fetcher dist.Fetcher) error {
+	store, err := dist.NewBlobStore(storeRoot)
+	if err != nil {
+		return err
+	}
+	p.blobStore = store
+	p.distClient = dist.NewClient(store, fetcher)
+	return nil
+}
+
+// LoadOption configures an individual call to Load.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	alias string
+}
+
+// WithAlias loads the plugin under a distinct logical name rather than
+// its native name:version, so the same binary can be loaded more than
+// once under different identities (e.g. "mock-prod" and "mock-canary"
+// pointing at the same collector with different config policies).
+func WithAlias(name string) LoadOption {
+	return func(o *loadOptions) {
+		o.alias = name
+	}
+}
+
 // Load is the public method to load a plugin into
 // the LoadedPlugins array and issue an event when
-// successful.
-func (p *pluginControl) Load(path string) error {
+// successful. path may be either a filesystem path to a plugin
+// executable, or (once ConfigureDistribution has been called) an
+// OCI-style reference such as "snap-collector-mock:1@sha256:<digest>",
+// in which case the plugin is pulled and verified before loading.
+func (p *pluginControl) Load(path string, opts ...LoadOption) error {
 	// logger.Debug("control.load", fmt.Sprintf("load called on path: %s", path))
 	if !p.Started {
 		return errors.New("Must start Controller before calling Load()")
 	}
 
-	if _, err := p.pluginManager.LoadPlugin(path, p.eventManager); err != nil {
+	lo := &loadOptions{}
+	for _, opt := range opts {
+		opt(lo)
+	}
+
+	pluginName := ""
+	digest := ""
+	if isPluginRef(path) {
+		resolved, resolvedName, resolvedDigest, err := p.Pull(path)
+		if err != nil {
+			return err
+		}
+		path = resolved
+		pluginName = resolvedName
+		digest = resolvedDigest
+	}
+
+	if pluginName != "" {
+		if err := p.checkPrivileges(pluginName); err != nil {
+			return err
+		}
+	}
+
+	if _, err := p.pluginManager.LoadPlugin(path, p.eventManager, lo.alias, digest); err != nil {
 		return err
 	}
 
@@ -150,6 +280,53 @@ func (p *pluginControl) Load(path string) error {
 	return nil
 }
 
+// Pull resolves an OCI-style plugin reference against the configured
+// distribution client, verifies the manifest and binary layer against
+// their declared digests, and returns the local blobstore path at which
+// the verified binary can be found, along with the plugin name and
+// binary digest the manifest itself declares (which is what privilege
+// checks and CatalogedPlugin.Digest() are keyed on — a reference's name
+// is only a request, the manifest's name and digest are what was
+// actually verified). It does not load the plugin.
+func (p *pluginControl) Pull(ref string) (path string, pluginName string, digest string, err error) {
+	if p.distClient == nil {
+		return "", "", "", errors.New("control.pull: no distribution client configured, call ConfigureDistribution first")
+	}
+
+	cfg, path, err := p.distClient.Pull(ref)
+	if err != nil {
+		event := &control_event.PluginVerificationFailedEvent{Reference: ref, Error: err.Error()}
+		p.eventManager.Emit(event)
+		return "", "", "", err
+	}
+
+	logger.Info("control.pull", fmt.Sprintf("pulled %s (sha256:%s)", ref, cfg.Digest()))
+
+	p.manifestPrivilegesMu.Lock()
+	p.manifestPrivileges[cfg.Name] = cfg.RequiredPrivileges
+	p.manifestPrivilegesMu.Unlock()
+
+	event := &control_event.PluginPulledEvent{
+		Name:    cfg.Name,
+		Version: cfg.Version,
+		Digest:  cfg.Digest(),
+	}
+	defer p.eventManager.Emit(event)
+
+	return path, cfg.Name, cfg.Digest(), nil
+}
+
+// isPluginRef reports whether path looks like an OCI-style plugin
+// reference ("name[:version][@sha256:digest]") rather than a filesystem
+// path. A string that exists on disk is always treated as a path.
+func isPluginRef(path string) bool {
+	if _, err := os.Stat(path); err == nil {
+		return false
+	}
+	_, err := dist.ParseRef(path)
+	return err == nil
+}
+
 func (p *pluginControl) Unload(pl CatalogedPlugin) error {
 	err := p.pluginManager.UnloadPlugin(pl)
 	if err != nil {
@@ -161,13 +338,25 @@ func (p *pluginControl) Unload(pl CatalogedPlugin) error {
 	return nil
 }
 
+// SwapPlugins loads inPath, pins its status to PluginLoaded so it is not
+// yet selectable for collection (getPool) or publishing, and only then unloads
+// out. The incoming plugin stays staged — present in the catalog, but
+// ineligible for routing — until a caller explicitly Enables it, so a
+// freshly swapped-in plugin can be verified before it ever serves a call.
 func (p *pluginControl) SwapPlugins(inPath string, out CatalogedPlugin) error {
 
-	lp, err := p.pluginManager.LoadPlugin(inPath, p.eventManager)
+	lp, err := p.pluginManager.LoadPlugin(inPath, p.eventManager, "", "")
 	if err != nil {
 		return err
 	}
 
+	if err := p.pluginManager.SetPluginStatus(lp.Key(), string(PluginLoaded)); err != nil {
+		if err2 := p.pluginManager.UnloadPlugin(lp); err2 != nil {
+			return errors.New("failed to rollback after error" + err2.Error() + " -- " + err.Error())
+		}
+		return err
+	}
+
 	err = p.pluginManager.UnloadPlugin(out)
 	if err != nil {
 		err2 := p.pluginManager.UnloadPlugin(lp)
@@ -183,6 +372,130 @@ func (p *pluginControl) SwapPlugins(inPath string, out CatalogedPlugin) error {
 	return nil
 }
 
+// PluginStatus is the lifecycle state of a loaded plugin.
+type PluginStatus string
+
+const (
+	// PluginLoaded means the plugin's binary has been validated and its
+	// metric catalog populated, but it is not yet selectable for
+	// collection or publishing.
+	PluginLoaded PluginStatus = "loaded"
+	// PluginEnabled means the plugin is selectable by
+	// groupMetricTypesByPlugin and the routing strategy.
+	PluginEnabled PluginStatus = "enabled"
+	// PluginDisabled means the plugin has been quiesced: its catalog
+	// entries remain, but it is no longer selectable, and its running
+	// processes have been stopped.
+	PluginDisabled PluginStatus = "disabled"
+)
+
+// defaultDrainTimeout bounds how long Disable waits for in-flight calls
+// against a plugin's pool to finish before it stops the plugin's
+// processes anyway.
+const defaultDrainTimeout = 5 * time.Second
+
+// EnableConfig carries the options used when transitioning a plugin
+// through Enable/Disable.
+type EnableConfig struct {
+	// DrainTimeout bounds how long Disable waits for outstanding calls
+	// against the plugin's pool to bleed off before stopping its
+	// processes unconditionally.
+	DrainTimeout time.Duration
+}
+
+// NewEnableConfig returns an EnableConfig with the package defaults.
+func NewEnableConfig() *EnableConfig {
+	return &EnableConfig{DrainTimeout: defaultDrainTimeout}
+}
+
+// Enable makes a loaded-but-not-yet-enabled plugin selectable for
+// collection/publishing. cfg may be nil to accept the defaults.
+func (p *pluginControl) Enable(pl CatalogedPlugin, cfg *EnableConfig) error {
+	if cfg == nil {
+		cfg = NewEnableConfig()
+	}
+
+	if pl.Status() == string(PluginEnabled) {
+		return errors.New(fmt.Sprintf("plugin (%s) is already enabled", pl.Key()))
+	}
+
+	if err := p.checkPrivileges(pl.Name()); err != nil {
+		return err
+	}
+
+	if err := p.pluginManager.SetPluginStatus(pl.Key(), string(PluginEnabled)); err != nil {
+		return err
+	}
+
+	p.enableConfigsMu.Lock()
+	p.enableConfigs[pl.Key()] = cfg
+	p.enableConfigsMu.Unlock()
+
+	event := &control_event.PluginEnabledEvent{PluginName: pl.Name(), PluginVersion: pl.Version()}
+	defer p.eventManager.Emit(event)
+
+	return nil
+}
+
+// Disable quiesces a plugin so it is no longer selectable for collection
+// or publishing, drains outstanding calls against its pool (bounded by
+// the DrainTimeout from the EnableConfig passed to Enable), and then
+// stops its running processes. The plugin is not unloaded and its
+// catalog entries are left intact, so it can be re-enabled without a reload.
+func (p *pluginControl) Disable(pl CatalogedPlugin) error {
+	if pl.Status() == string(PluginDisabled) {
+		return errors.New(fmt.Sprintf("plugin (%s) is already disabled", pl.Key()))
+	}
+
+	p.enableConfigsMu.Lock()
+	cfg, ok := p.enableConfigs[pl.Key()]
+	p.enableConfigsMu.Unlock()
+	if !ok {
+		cfg = NewEnableConfig()
+	}
+
+	// Flip status first so getPool and PublishMetrics refuse new calls
+	// while we drain the ones already in flight.
+	if err := p.pluginManager.SetPluginStatus(pl.Key(), string(PluginDisabled)); err != nil {
+		return err
+	}
+
+	if err := p.drain(pl.Key(), cfg.DrainTimeout); err != nil {
+		logger.Warn("control.disable", fmt.Sprintf("draining plugin (%s): %v", pl.Key(), err))
+	}
+
+	if errs := p.pluginRunner.StopPluginsForKey(pl.Key()); len(errs) > 0 {
+		return errs[0]
+	}
+
+	event := &control_event.PluginDisabledEvent{PluginName: pl.Name(), PluginVersion: pl.Version()}
+	defer p.eventManager.Emit(event)
+
+	return nil
+}
+
+// drain blocks until every in-flight call against pluginKey's pool has
+// completed, or timeout elapses, whichever comes first.
+func (p *pluginControl) drain(pluginKey string, timeout time.Duration) error {
+	avail := p.pluginRunner.AvailablePlugins()
+	pool := avail.Collectors.GetPluginPool(pluginKey)
+	if pool == nil {
+		pool = avail.Publishers.GetPluginPool(pluginKey)
+	}
+	if pool == nil {
+		return nil
+	}
+
+	deadline := time.Now().Add(timeout)
+	for pool.InFlight() > 0 {
+		if time.Now().After(deadline) {
+			return errors.New(fmt.Sprintf("timed out after %s waiting for %d in-flight call(s) to drain", timeout, pool.InFlight()))
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return nil
+}
+
 // SubscribeMetricType validates the given config data, and if valid
 // returns a MetricType with a config.  On error a collection of errors is returned
 // either from config data processing, or the inability to find the metric.
@@ -209,6 +522,11 @@ func (p *pluginControl) SubscribeMetricType(mt core.MetricType, cd *cdata.Config
 	m.config = cdata.FromTable(*ncdTable)
 
 	m.Subscribe()
+
+	if lp, lerr := p.metricCatalog.GetPlugin(mt.Namespace(), mt.Version()); lerr == nil && lp != nil {
+		p.incrementSubscriptionCount(lp.Key())
+	}
+
 	e := &control_event.MetricSubscriptionEvent{
 		MetricNamespace: m.Namespace(),
 		Version:         m.Version(),
@@ -244,7 +562,7 @@ func (p *pluginControl) SubscribePublisher(name string, ver int, config map[stri
 		return errs.Errors()
 	}
 
-	//TODO store subscription counts for publishers
+	p.incrementSubscriptionCount(lp.Key())
 
 	e := &control_event.PublisherSubscriptionEvent{
 		PluginName:    name,
@@ -259,6 +577,11 @@ func (p *pluginControl) SubscribePublisher(name string, ver int, config map[stri
 // If subscriptions fall below zero we will panic.
 func (p *pluginControl) UnsubscribeMetricType(mt core.MetricType) {
 	logger.Info("control.subscribe", fmt.Sprintf("unsubscription called with: %s", mt.Namespace()))
+
+	if lp, lerr := p.metricCatalog.GetPlugin(mt.Namespace(), mt.Version()); lerr == nil && lp != nil {
+		p.decrementSubscriptionCount(lp.Key())
+	}
+
 	err := p.metricCatalog.Unsubscribe(mt.Namespace(), mt.Version())
 	if err != nil {
 		// panic because if a metric falls below 0, something bad has happened
@@ -270,11 +593,82 @@ func (p *pluginControl) UnsubscribeMetricType(mt core.MetricType) {
 	p.eventManager.Emit(e)
 }
 
+// incrementSubscriptionCount and decrementSubscriptionCount track how many
+// active subscriptions (collector metrics or publisher configs) are
+// currently held against a given plugin key, for Inspect to report.
+func (p *pluginControl) incrementSubscriptionCount(key string) {
+	p.subscriptionCountsMu.Lock()
+	p.subscriptionCounts[key]++
+	p.subscriptionCountsMu.Unlock()
+}
+
+func (p *pluginControl) decrementSubscriptionCount(key string) {
+	p.subscriptionCountsMu.Lock()
+	if p.subscriptionCounts[key] > 0 {
+		p.subscriptionCounts[key]--
+	}
+	p.subscriptionCountsMu.Unlock()
+}
+
+func (p *pluginControl) subscriptionCount(key string) int {
+	p.subscriptionCountsMu.Lock()
+	defer p.subscriptionCountsMu.Unlock()
+	return p.subscriptionCounts[key]
+}
+
 // SetMonitorOptions exposes monitors options
 func (p *pluginControl) SetMonitorOptions(options ...monitorOption) {
 	p.pluginRunner.Monitor().Option(options...)
 }
 
+// SetPrivilegeAllowlist configures the set of privileges a plugin may
+// declare in its manifest (see control/dist.PluginConfig) and still be
+// allowed to Load or Enable. An unset (nil) allowlist permits any
+// privilege, which is the default and preserves behavior for callers
+// that never configure one. This is NOT the same as passing an empty,
+// non-nil slice ([]string{}): that is a deliberate "deny every
+// privilege" allowlist, since an operator who explicitly configures an
+// empty list is taken to mean exactly that.
+func (p *pluginControl) SetPrivilegeAllowlist(privileges []string) {
+	p.privilegeAllowlist = privileges
+}
+
+// checkPrivileges refuses to activate a plugin whose manifest-declared
+// privileges exceed the control-wide allowlist, emitting
+// PluginPrivilegeDeniedEvent when it does. Plugins with no recorded
+// manifest (i.e. loaded from a bare filesystem path) are unaffected. A
+// nil allowlist (SetPrivilegeAllowlist never called) permits everything;
+// an explicitly configured empty allowlist permits nothing.
+func (p *pluginControl) checkPrivileges(pluginName string) error {
+	if p.privilegeAllowlist == nil {
+		return nil
+	}
+
+	p.manifestPrivilegesMu.Lock()
+	required, ok := p.manifestPrivileges[pluginName]
+	p.manifestPrivilegesMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	allowed := make(map[string]bool, len(p.privilegeAllowlist))
+	for _, a := range p.privilegeAllowlist {
+		allowed[a] = true
+	}
+
+	for _, req := range required {
+		if !allowed[req] {
+			event := &control_event.PluginPrivilegeDeniedEvent{
+				PluginName: pluginName,
+				Privilege:  req,
+			}
+			p.eventManager.Emit(event)
+			return errors.New(fmt.Sprintf("plugin (%s) requires privilege %q which is not in the control allowlist", pluginName, req))
+		}
+	}
+	return nil
+}
+
 // the public interface for a plugin
 // this should be the contract for
 // how mgmt modules know a plugin
@@ -284,6 +678,17 @@ type CatalogedPlugin interface {
 	TypeName() string
 	Status() string
 	LoadedTimestamp() int64
+	// Digest returns the hex sha256 digest of the plugin binary that is
+	// actually running, so operators can audit exactly what was loaded.
+	// It is empty for plugins loaded from a bare filesystem path rather
+	// than a content-addressed reference.
+	Digest() string
+	// Key uniquely identifies the loaded plugin instance for pool and
+	// subscription lookups.
+	Key() string
+	// Path returns the filesystem path of the plugin binary that was
+	// loaded, for use with managesPlugins.GenerateArgs.
+	Path() string
 }
 
 // the collection of cataloged plugins used
@@ -321,94 +726,317 @@ func (p *pluginControl) MetricExists(mns []string, ver int) bool {
 	return false
 }
 
-// CollectMetrics is a blocking call to collector plugins returning a collection
-// of metrics and errors.  If an error is encountered no metrics will be
-// returned.
-func (p *pluginControl) CollectMetrics(
-	metricTypes []core.MetricType,
-	deadline time.Time,
-) (metrics []core.Metric, errs []error) {
+// PluginInspect is a single structured document auditors can use to see
+// everything control knows about a loaded plugin: what it declares about
+// itself in its manifest, what it is currently doing, and what it is
+// permitted to do.
+type PluginInspect struct {
+	Name    string
+	Version int
+	Status  string
+
+	// Digest is the hex sha256 digest of the running binary, empty for
+	// plugins loaded from a bare filesystem path.
+	Digest string
+
+	// Namespaces lists every metric namespace this plugin has
+	// registered into the metric catalog.
+	Namespaces [][]string
+
+	// RuntimeArgs are the arguments control generates (via GenerateArgs)
+	// for spawning this plugin's executable, computed when Inspect is called.
+	RuntimeArgs plugin.Arg
+
+	// SubscriptionCount is the number of currently active
+	// subscriptions (collector metrics or a publisher config) held
+	// against this plugin.
+	SubscriptionCount int
+
+	// PoolSize is the number of running instances currently available
+	// to serve calls for this plugin.
+	PoolSize int
+
+	// HitCount and LastHitTime summarize traffic across every instance
+	// in the plugin's pool.
+	HitCount    int
+	LastHitTime time.Time
+
+	// RequiredPrivileges are the privileges declared in this plugin's
+	// manifest (host networking, filesystem paths, capabilities, etc).
+	// Empty for plugins loaded from a bare filesystem path.
+	RequiredPrivileges []string
+}
+
+// Inspect returns a structured audit document for a loaded plugin,
+// covering its manifest digest, declared metric types, subscription and
+// pool activity, and required privileges. name is resolved first as an
+// alias (see LoadOption/WithAlias) so an individual instance such as
+// "mock-prod" can be targeted even when other instances share its
+// plugin name; failing that, name is matched against loaded plugin
+// names and version pins to a specific loaded version, or -1 to select
+// the newest version loaded under that name.
+func (p *pluginControl) Inspect(name string, version int) (*PluginInspect, error) {
+	lp, err := p.metricCatalog.GetPluginByAlias(name)
+	if err != nil {
+		lp = nil
+	}
+
+	if lp == nil {
+		p.pluginManager.LoadedPlugins().Lock()
+		for p.pluginManager.LoadedPlugins().Next() {
+			_, l := p.pluginManager.LoadedPlugins().Item()
+			if l.Name() != name {
+				continue
+			}
+			if version >= 0 && l.Version() != version {
+				continue
+			}
+			if lp == nil || l.Version() > lp.Version() {
+				lp = l
+			}
+		}
+		p.pluginManager.LoadedPlugins().Unlock()
+	}
+	if lp == nil {
+		return nil, errors.New(fmt.Sprintf("no loaded plugin found for name: %v", name))
+	}
+
+	p.manifestPrivilegesMu.Lock()
+	privileges := p.manifestPrivileges[lp.Name()]
+	p.manifestPrivilegesMu.Unlock()
+
+	pi := &PluginInspect{
+		Name:               lp.Name(),
+		Version:            lp.Version(),
+		Status:             lp.Status(),
+		Digest:             lp.Digest(),
+		Namespaces:         p.metricCatalog.NamespacesForPlugin(lp.Key()),
+		RuntimeArgs:        p.pluginManager.GenerateArgs(lp.Path()),
+		SubscriptionCount:  p.subscriptionCount(lp.Key()),
+		RequiredPrivileges: privileges,
+	}
+
+	pool := p.pluginRunner.AvailablePlugins().Collectors.GetPluginPool(lp.Key())
+	if pool == nil {
+		pool = p.pluginRunner.AvailablePlugins().Publishers.GetPluginPool(lp.Key())
+	}
+	if pool != nil {
+		pi.PoolSize = pool.Count()
+		pi.HitCount, pi.LastHitTime = pool.Stats()
+	}
+
+	return pi, nil
+}
+
+// defaultCollectRetryAttempts bounds how many attempts in total (the first
+// one included) CollectMetrics will make for a single plugin key across
+// instances in its pool before giving up on it.
+const defaultCollectRetryAttempts = 3
+
+// defaultCollectTimeout is the per-plugin-call deadline used when ctx
+// carries none of its own.
+const defaultCollectTimeout = 5 * time.Second
+
+// collectResult is the slot each per-plugin goroutine writes into. Using
+// a preallocated, index-addressed slice (one slot per plugin key) instead
+// of a shared append target means no two goroutines ever write the same
+// memory, so there is nothing to race.
+type collectResult struct {
+	metrics []core.Metric
+	err     error
+}
 
+// CollectMetrics is a blocking call to collector plugins returning a
+// collection of metrics and errors. ctx bounds the whole call; each
+// per-plugin RPC further derives its own deadline from ctx (or a default,
+// if ctx carries none). A plugin whose call errors, or hits the default
+// per-call timeout, is transparently retried against another available
+// instance from the same pool, up to CollectRetryAttempts attempts in all,
+// with the failing instance marked for the monitor to recycle. Once ctx
+// itself is done, no further attempt is made and no instance is marked.
+func (p *pluginControl) CollectMetrics(ctx context.Context, metricTypes []core.MetricType) ([]core.Metric, []error) {
 	pluginToMetricMap, err := groupMetricTypesByPlugin(p.metricCatalog, metricTypes)
 	if err != nil {
-		errs = append(errs, err)
-		return
+		return nil, []error{err}
 	}
 
-	cMetrics := make(chan []core.Metric)
-	cError := make(chan error)
-	var wg sync.WaitGroup
+	keys := make([]string, 0, len(pluginToMetricMap))
+	for pluginKey := range pluginToMetricMap {
+		keys = append(keys, pluginKey)
+	}
 
-	// For each available plugin call available plugin using RPC client and wait for response (goroutines)
-	for pluginKey, pmt := range pluginToMetricMap {
+	results := make([]collectResult, len(keys))
 
-		// resolve a pool (from catalog)
-		pool, err := getPool(pluginKey, p.pluginRunner.AvailablePlugins())
-		if err != nil {
-			errs = append(errs, err)
+	var wg sync.WaitGroup
+	wg.Add(len(keys))
+	for i, pluginKey := range keys {
+		go func(i int, pluginKey string) {
+			defer wg.Done()
+			pmt := pluginToMetricMap[pluginKey]
+			m, err := p.collectFromPlugin(ctx, pluginKey, pmt)
+			results[i] = collectResult{metrics: m, err: err}
+		}(i, pluginKey)
+	}
+	wg.Wait()
+
+	var metrics []core.Metric
+	var errs []error
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
 			continue
 		}
+		metrics = append(metrics, r.metrics...)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return metrics, nil
+}
+
+// collectFromPlugin resolves pluginKey's pool once, then retries across
+// instances in that pool until one succeeds or attempts are exhausted.
+//
+// Not end-to-end unit tested here: exercising this would need a fake
+// client.PluginCollectorClient plus a two-instance *availablePluginPool
+// to drive retry-then-succeed and deadline-expiry behavior directly, but
+// availablePlugin/availablePluginPool/loadedPlugin/monitor are concrete
+// types defined in control package files outside this checkout (see
+// getPool/getAvailablePlugin below), so a test double can't be built
+// without guessing at their internal layout. collectRetryAttempts and
+// deriveCallContext (control_test.go) and the standalone dist/routing
+// tests are what's covered instead; this is the one piece of the
+// retry/deadline rewrite still unverified by a test in this tree.
+func (p *pluginControl) collectFromPlugin(ctx context.Context, pluginKey string, pmt pluginMetricTypes) ([]core.Metric, error) {
+	pool, err := getPool(pluginKey, pmt.plugin, p.pluginRunner.AvailablePlugins())
+	if err != nil {
+		return nil, err
+	}
 
-		// resolve a available plugin from pool
+	attempts := p.collectRetryAttempts()
+	tried := 0
+	var lastErr error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		// If the caller's deadline is already gone, every remaining
+		// instance would fail the same way for the same reason; stop
+		// instead of working through (and recycling) the whole pool.
+		if ctx.Err() != nil {
+			lastErr = ctx.Err()
+			break
+		}
+
+		// Every attempt, including retries, goes through the control's
+		// configured routing strategy: retrying with a different
+		// strategy (e.g. steering toward the least-loaded instance)
+		// would need that strategy to implement the same RoutingStrategy
+		// contract pool.SelectUsingStrategy expects, which isn't defined
+		// in this package (see routing.LeastLoaded's doc comment).
+		p.instanceMetricsMu.Lock()
 		ap, err := getAvailablePlugin(pool, p.strategy)
+		p.instanceMetricsMu.Unlock()
 		if err != nil {
-			errs = append(errs, err)
-			continue
+			return nil, err
 		}
 
-		// cast client to PluginCollectorClient
 		cli, ok := ap.Client.(client.PluginCollectorClient)
 		if !ok {
-			err := errors.New("unable to cast client to PluginCollectorClient")
-			errs = append(errs, err)
-			continue
+			return nil, errors.New("unable to cast client to PluginCollectorClient")
 		}
 
-		wg.Add(1)
-
-		// get a metrics
-		go func(mt []core.MetricType) {
-			metrics, err = cli.CollectMetrics(mt)
-			if err != nil {
-				cError <- err
-			} else {
-				cMetrics <- metrics
-			}
-		}(pmt.metricTypes)
+		tried++
+		callCtx, cancel := deriveCallContext(ctx)
+		metrics, err := collectWithContext(callCtx, cli, pmt.metricTypes)
+		cancel()
 
-		// update statics about plugin
+		p.instanceMetricsMu.Lock()
 		ap.hitCount++
 		ap.lastHitTime = time.Now()
-	}
+		p.instanceMetricsMu.Unlock()
 
-	go func() {
-		for m := range cMetrics {
-			metrics = append(metrics, m...)
-			wg.Done()
+		if err == nil {
+			return metrics, nil
 		}
-	}()
 
-	go func() {
-		for e := range cError {
-			errs = append(errs, e)
-			wg.Done()
+		lastErr = err
+
+		if ctx.Err() != nil {
+			// The outer deadline expired during this call; the
+			// instance didn't necessarily misbehave, so don't recycle
+			// it and don't burn further attempts against other instances.
+			break
 		}
+
+		logger.Warn("control.collect", fmt.Sprintf(
+			"plugin (%s) attempt %d/%d failed: %v, marking instance for recycle and retrying",
+			pluginKey, attempt, attempts, err))
+		p.pluginRunner.Monitor().MarkForRecycle(ap)
+	}
+
+	return nil, errors.New(fmt.Sprintf("plugin (%s) failed after %d attempts: %v", pluginKey, tried, lastErr))
+}
+
+// collectWithContext runs a (non-context-aware) collector RPC on its own
+// goroutine and returns as soon as either it completes or callCtx expires,
+// so a hung plugin cannot block CollectMetrics past its deadline. The
+// result channel is buffered so an abandoned goroutine's eventual send
+// never blocks after this function has already returned callCtx.Err() to
+// its caller. Note that a deadline expiry here does not itself recycle
+// the stuck instance: collectFromPlugin leaves the instance alone when
+// the outer ctx is done (ctx.Err() is the outer caller's problem, not
+// necessarily the instance's fault), and calls MarkForRecycle on an
+// instance that returned an RPC error or hit the default per-call timeout
+// while the outer ctx was still live.
+func collectWithContext(callCtx context.Context, cli client.PluginCollectorClient, mt []core.MetricType) ([]core.Metric, error) {
+	type rpcResult struct {
+		metrics []core.Metric
+		err     error
+	}
+	done := make(chan rpcResult, 1)
+	go func() {
+		m, err := cli.CollectMetrics(mt)
+		done <- rpcResult{metrics: m, err: err}
 	}()
 
-	wg.Wait()
-	close(cMetrics)
-	close(cError)
+	select {
+	case r := <-done:
+		return r.metrics, r.err
+	case <-callCtx.Done():
+		return nil, callCtx.Err()
+	}
+}
 
-	if len(errs) > 0 {
-		return nil, errs
+// deriveCallContext derives a per-call context bounded by ctx's deadline,
+// falling back to defaultCollectTimeout if ctx carries none.
+func deriveCallContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(defaultCollectTimeout)
 	}
-	return
+	return context.WithDeadline(ctx, deadline)
+}
+
+// collectRetryAttempts returns the configured number of per-plugin collect
+// attempts, falling back to defaultCollectRetryAttempts.
+func (p *pluginControl) collectRetryAttempts() int {
+	if p.CollectRetryAttempts > 0 {
+		return p.CollectRetryAttempts
+	}
+	return defaultCollectRetryAttempts
 }
 
 // PublishMetrics
 func (p *pluginControl) PublishMetrics(contentType string, content []byte, pluginName string, pluginVersion int, config map[string]ctypes.ConfigValue) []error {
 	key := strings.Join([]string{pluginName, strconv.Itoa(pluginVersion)}, ":")
 
+	lp, err := p.pluginManager.LoadedPlugins().GetByKey(key)
+	if err != nil {
+		return []error{err}
+	}
+	if lp.Status() != string(PluginEnabled) {
+		return []error{errors.New(fmt.Sprintf("publisher (%s) is %s, not enabled", key, lp.Status()))}
+	}
+
 	pool := p.pluginRunner.AvailablePlugins().Publishers.GetPluginPool(key)
 	if pool == nil {
 		return []error{errors.New(fmt.Sprintf("No available plugin found for %v:%v", pluginName, pluginVersion))}
@@ -444,14 +1072,31 @@ func (p *pluginMetricTypes) Count() int {
 	return len(p.metricTypes)
 }
 
-// groupMetricTypesByPlugin groups metricTypes by a plugin.Key() and returns appropriate structure
+// groupMetricTypesByPlugin groups metricTypes by a plugin.Key() and
+// returns appropriate structure. A metricType pinned to an alias (see
+// aliasedMetricType/LoadOption.WithAlias) resolves via
+// catalogsMetrics.GetPluginByAlias so it always targets that specific
+// loaded instance; otherwise it resolves by namespace to the newest
+// loaded version.
+//
+// Not unit tested here: every case requires a value satisfying
+// core.MetricType, an external interface whose full method set isn't
+// visible in this checkout (only the Namespace() usage below is known),
+// so a test double can't be built without guessing at it.
 func groupMetricTypesByPlugin(cat catalogsMetrics, metricTypes []core.MetricType) (map[string]pluginMetricTypes, error) {
 	pmts := make(map[string]pluginMetricTypes)
 	// For each plugin type select a matching available plugin to call
 	for _, mt := range metricTypes {
 
-		// This is set to choose the newest and not pin version. TODO, be sure version is set to -1 if not provided by user on Task creation.
-		lp, err := cat.GetPlugin(mt.Namespace(), -1)
+		var lp *loadedPlugin
+		var err error
+		if am, ok := mt.(aliasedMetricType); ok && am.Alias() != "" {
+			// pinned to one specific loaded instance, e.g. "mock-canary"
+			lp, err = cat.GetPluginByAlias(am.Alias())
+		} else {
+			// This is set to choose the newest and not pin version. TODO, be sure version is set to -1 if not provided by user on Task creation.
+			lp, err = cat.GetPlugin(mt.Namespace(), -1)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -475,7 +1120,11 @@ func groupMetricTypesByPlugin(cat catalogsMetrics, metricTypes []core.MetricType
 }
 
 // getPool finds a pool for a given pluginKey and checks is not empty
-func getPool(pluginKey string, availablePlugins *availablePlugins) (*availablePluginPool, error) {
+func getPool(pluginKey string, lp *loadedPlugin, availablePlugins *availablePlugins) (*availablePluginPool, error) {
+
+	if lp.Status() != string(PluginEnabled) {
+		return nil, errors.New(fmt.Sprintf("plugin (%s) is %s, not enabled", pluginKey, lp.Status()))
+	}
 
 	pool := availablePlugins.Collectors.GetPluginPool(pluginKey)
 
@@ -0,0 +1,130 @@
+package control
+
+import (
+	"testing"
+
+	"github.com/intelsdilabs/gomit"
+	"github.com/intelsdilabs/pulse/control/plugin"
+)
+
+// fakeCatalogedPlugin is a minimal CatalogedPlugin double: every field the
+// lifecycle methods under test read is settable directly.
+type fakeCatalogedPlugin struct {
+	name    string
+	version int
+	status  string
+	key     string
+}
+
+func (f *fakeCatalogedPlugin) Name() string           { return f.name }
+func (f *fakeCatalogedPlugin) Version() int           { return f.version }
+func (f *fakeCatalogedPlugin) TypeName() string       { return "collector" }
+func (f *fakeCatalogedPlugin) Status() string         { return f.status }
+func (f *fakeCatalogedPlugin) LoadedTimestamp() int64 { return 0 }
+func (f *fakeCatalogedPlugin) Digest() string         { return "" }
+func (f *fakeCatalogedPlugin) Key() string            { return f.key }
+func (f *fakeCatalogedPlugin) Path() string           { return "" }
+
+// fakeManagesPlugins is a managesPlugins double that only records
+// SetPluginStatus calls; every other method is unused by Enable/Disable's
+// early-return paths and returns its zero value. LoadPlugin/LoadedPlugins
+// return nil *loadedPlugin/*loadedPlugins, which is fine as long as the
+// test path under exercise never dereferences them.
+type fakeManagesPlugins struct {
+	setStatusCalls []string // "key:status"
+	setStatusErr   error
+}
+
+func (f *fakeManagesPlugins) LoadPlugin(path string, emitter gomit.Emitter, alias string, digest string) (*loadedPlugin, error) {
+	return nil, nil
+}
+func (f *fakeManagesPlugins) UnloadPlugin(CatalogedPlugin) error { return nil }
+func (f *fakeManagesPlugins) LoadedPlugins() *loadedPlugins      { return nil }
+func (f *fakeManagesPlugins) SetMetricCatalog(catalogsMetrics)   {}
+func (f *fakeManagesPlugins) GenerateArgs(pluginPath string) plugin.Arg {
+	return plugin.Arg{}
+}
+func (f *fakeManagesPlugins) SetPluginStatus(key string, status string) error {
+	f.setStatusCalls = append(f.setStatusCalls, key+":"+status)
+	return f.setStatusErr
+}
+
+func newTestPluginControlForLifecycle(pm *fakeManagesPlugins) *pluginControl {
+	return &pluginControl{
+		eventManager:       gomit.NewEventController(),
+		pluginManager:      pm,
+		enableConfigs:      make(map[string]*EnableConfig),
+		manifestPrivileges: make(map[string][]string),
+	}
+}
+
+func TestEnableTransitionsStatusAndRecordsConfig(t *testing.T) {
+	pm := &fakeManagesPlugins{}
+	p := newTestPluginControlForLifecycle(pm)
+	pl := &fakeCatalogedPlugin{name: "snap-collector-mock", version: 1, status: string(PluginLoaded), key: "snap-collector-mock:1"}
+
+	if err := p.Enable(pl, nil); err != nil {
+		t.Fatalf("Enable returned error: %v", err)
+	}
+
+	if len(pm.setStatusCalls) != 1 || pm.setStatusCalls[0] != "snap-collector-mock:1:enabled" {
+		t.Errorf("SetPluginStatus calls = %v, want exactly one call transitioning to enabled", pm.setStatusCalls)
+	}
+
+	cfg, ok := p.enableConfigs[pl.Key()]
+	if !ok {
+		t.Fatal("Enable did not record an EnableConfig for the plugin")
+	}
+	if cfg.DrainTimeout != defaultDrainTimeout {
+		t.Errorf("Enable with a nil cfg recorded DrainTimeout %v, want the default %v", cfg.DrainTimeout, defaultDrainTimeout)
+	}
+}
+
+func TestEnableAlreadyEnabledIsRejected(t *testing.T) {
+	pm := &fakeManagesPlugins{}
+	p := newTestPluginControlForLifecycle(pm)
+	pl := &fakeCatalogedPlugin{name: "snap-collector-mock", version: 1, status: string(PluginEnabled), key: "snap-collector-mock:1"}
+
+	if err := p.Enable(pl, nil); err == nil {
+		t.Fatal("expected Enable to reject a plugin that is already enabled")
+	}
+	if len(pm.setStatusCalls) != 0 {
+		t.Errorf("Enable on an already-enabled plugin should not transition status, got calls %v", pm.setStatusCalls)
+	}
+}
+
+func TestEnableDeniedByPrivilegeAllowlist(t *testing.T) {
+	pm := &fakeManagesPlugins{}
+	p := newTestPluginControlForLifecycle(pm)
+	p.SetPrivilegeAllowlist([]string{"network"})
+	p.manifestPrivileges["snap-collector-mock"] = []string{"disk"}
+	pl := &fakeCatalogedPlugin{name: "snap-collector-mock", version: 1, status: string(PluginLoaded), key: "snap-collector-mock:1"}
+
+	if err := p.Enable(pl, nil); err == nil {
+		t.Fatal("expected Enable to be denied for a plugin declaring a privilege outside the allowlist")
+	}
+	if len(pm.setStatusCalls) != 0 {
+		t.Errorf("a privilege-denied Enable should not transition status, got calls %v", pm.setStatusCalls)
+	}
+}
+
+// TestDisableAlreadyDisabled is the only Disable path exercised here:
+// Disable's success path calls drain, which reaches into
+// pluginRunner.AvailablePlugins()'s concrete *availablePlugins/pool types.
+// Those types live in control package files outside this checkout (see
+// getPool/getAvailablePlugin), so there is no way to construct a fake
+// pool for them without guessing an unknown struct layout; the
+// already-disabled early return is the one branch that runs before any
+// of that is touched.
+func TestDisableAlreadyDisabled(t *testing.T) {
+	pm := &fakeManagesPlugins{}
+	p := newTestPluginControlForLifecycle(pm)
+	pl := &fakeCatalogedPlugin{name: "snap-collector-mock", version: 1, status: string(PluginDisabled), key: "snap-collector-mock:1"}
+
+	if err := p.Disable(pl); err == nil {
+		t.Fatal("expected Disable to reject a plugin that is already disabled")
+	}
+	if len(pm.setStatusCalls) != 0 {
+		t.Errorf("Disable on an already-disabled plugin should not transition status, got calls %v", pm.setStatusCalls)
+	}
+}
@@ -0,0 +1,63 @@
+package control
+
+import (
+	"testing"
+
+	"github.com/intelsdilabs/gomit"
+)
+
+func newTestPluginControlForPrivileges() *pluginControl {
+	return &pluginControl{
+		eventManager:       gomit.NewEventController(),
+		manifestPrivileges: make(map[string][]string),
+	}
+}
+
+func TestCheckPrivilegesNilAllowlistPermitsEverything(t *testing.T) {
+	p := newTestPluginControlForPrivileges()
+	p.manifestPrivileges["snap-collector-mock"] = []string{"network", "disk"}
+
+	if err := p.checkPrivileges("snap-collector-mock"); err != nil {
+		t.Errorf("checkPrivileges with a nil (unconfigured) allowlist returned error: %v", err)
+	}
+}
+
+func TestCheckPrivilegesEmptyAllowlistDeniesDeclaredPrivileges(t *testing.T) {
+	p := newTestPluginControlForPrivileges()
+	p.SetPrivilegeAllowlist([]string{})
+	p.manifestPrivileges["snap-collector-mock"] = []string{"network"}
+
+	if err := p.checkPrivileges("snap-collector-mock"); err == nil {
+		t.Error("expected an explicitly empty allowlist to deny a plugin that declares any privilege")
+	}
+}
+
+func TestCheckPrivilegesEmptyAllowlistStillPermitsNoManifestPlugin(t *testing.T) {
+	p := newTestPluginControlForPrivileges()
+	p.SetPrivilegeAllowlist([]string{})
+
+	if err := p.checkPrivileges("loaded-from-path"); err != nil {
+		t.Errorf("checkPrivileges on a plugin with no recorded manifest returned error: %v", err)
+	}
+}
+
+func TestCheckPrivilegesAllowed(t *testing.T) {
+	p := newTestPluginControlForPrivileges()
+	p.SetPrivilegeAllowlist([]string{"network", "disk"})
+	p.manifestPrivileges["snap-collector-mock"] = []string{"network"}
+
+	if err := p.checkPrivileges("snap-collector-mock"); err != nil {
+		t.Errorf("checkPrivileges denied a privilege that is in the allowlist: %v", err)
+	}
+}
+
+func TestCheckPrivilegesDenied(t *testing.T) {
+	p := newTestPluginControlForPrivileges()
+	p.SetPrivilegeAllowlist([]string{"network"})
+	p.manifestPrivileges["snap-collector-mock"] = []string{"network", "disk"}
+
+	err := p.checkPrivileges("snap-collector-mock")
+	if err == nil {
+		t.Fatal("expected checkPrivileges to deny a privilege not in the allowlist")
+	}
+}
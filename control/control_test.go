@@ -0,0 +1,74 @@
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// These cases cover the retry-count and deadline-derivation policy that
+// collectFromPlugin's retry-then-succeed and deadline-expiry behavior is
+// built on. Exercising collectFromPlugin itself end-to-end would require
+// constructing a live availablePluginPool/loadedPlugin/PluginCollectorClient,
+// whose concrete types are defined in control (and control/plugin/client)
+// package files not present in this checkout; collectRetryAttempts and
+// deriveCallContext are the pieces
+// of that logic that are self-contained, so they're what's covered here.
+
+func TestCollectRetryAttemptsDefault(t *testing.T) {
+	p := &pluginControl{}
+	if got := p.collectRetryAttempts(); got != defaultCollectRetryAttempts {
+		t.Errorf("collectRetryAttempts() = %d, want default %d", got, defaultCollectRetryAttempts)
+	}
+}
+
+func TestCollectRetryAttemptsOverride(t *testing.T) {
+	p := &pluginControl{CollectRetryAttempts: 5}
+	if got := p.collectRetryAttempts(); got != 5 {
+		t.Errorf("collectRetryAttempts() = %d, want 5", got)
+	}
+}
+
+func TestDeriveCallContextInheritsExistingDeadline(t *testing.T) {
+	deadline := time.Now().Add(time.Second)
+	parent, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	callCtx, cancel2 := deriveCallContext(parent)
+	defer cancel2()
+
+	got, ok := callCtx.Deadline()
+	if !ok {
+		t.Fatal("expected derived context to carry a deadline")
+	}
+	if !got.Equal(deadline) {
+		t.Errorf("deriveCallContext deadline = %v, want parent's deadline %v", got, deadline)
+	}
+}
+
+func TestDeriveCallContextAppliesDefaultTimeout(t *testing.T) {
+	callCtx, cancel := deriveCallContext(context.Background())
+	defer cancel()
+
+	deadline, ok := callCtx.Deadline()
+	if !ok {
+		t.Fatal("expected derived context to carry a deadline even when the parent has none")
+	}
+	until := time.Until(deadline)
+	if until <= 0 || until > defaultCollectTimeout {
+		t.Errorf("derived deadline is %v out, want within (0, %v]", until, defaultCollectTimeout)
+	}
+}
+
+func TestDeriveCallContextExpiresWithParent(t *testing.T) {
+	parent, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	time.Sleep(5 * time.Millisecond)
+
+	callCtx, cancel2 := deriveCallContext(parent)
+	defer cancel2()
+
+	if callCtx.Err() == nil {
+		t.Error("expected derived context to already be expired when its parent deadline has passed")
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Package dist implements content-addressable storage and distribution of
+plugin binaries.  Plugins are referenced by the sha256 digest of their
+content, so the same bytes are guaranteed to be loaded on every node
+regardless of where they were pulled from.
+*/
+package dist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// BlobStore is a content-addressable store rooted at a directory on disk.
+// Blobs are named by the hex-encoded sha256 digest of their contents, so a
+// digest both names and verifies a blob.
+type BlobStore struct {
+	root string
+	mu   sync.RWMutex
+}
+
+// NewBlobStore returns a BlobStore rooted at root, creating the directory
+// if it does not already exist.
+func NewBlobStore(root string) (*BlobStore, error) {
+	if root == "" {
+		return nil, errors.New("blobstore: root path required")
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &BlobStore{root: root}, nil
+}
+
+// Digest returns the hex-encoded sha256 digest of content.
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// path returns the on-disk path for a given digest.
+func (b *BlobStore) path(digest string) string {
+	return filepath.Join(b.root, digest)
+}
+
+// Has returns true if a blob matching digest is already in the store.
+func (b *BlobStore) Has(digest string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, err := os.Stat(b.path(digest))
+	return err == nil
+}
+
+// Get returns the contents of the blob named by digest.
+func (b *BlobStore) Get(digest string) ([]byte, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return ioutil.ReadFile(b.path(digest))
+}
+
+// Put writes content into the store and returns its digest.
+func (b *BlobStore) Put(content []byte) (string, error) {
+	digest := Digest(content)
+	if err := b.PutVerified(digest, content); err != nil {
+		return "", err
+	}
+	return digest, nil
+}
+
+// PutVerified writes content into the store only after confirming it
+// hashes to digest.  It returns an error and leaves the store untouched if
+// the content does not match the expected digest.
+func (b *BlobStore) PutVerified(digest string, content []byte) error {
+	actual := Digest(content)
+	if actual != digest {
+		return fmt.Errorf("dist: digest mismatch, expected sha256:%s got sha256:%s", digest, actual)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := os.Stat(b.path(digest)); err == nil {
+		// already present and content-addressed, nothing to do
+		return nil
+	}
+
+	tmp, err := ioutil.TempFile(b.root, "blob-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), b.path(digest))
+}
@@ -0,0 +1,57 @@
+package dist
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func newTestBlobStore(t *testing.T) *BlobStore {
+	dir, err := ioutil.TempDir("", "blobstore-test")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	store, err := NewBlobStore(dir)
+	if err != nil {
+		t.Fatalf("NewBlobStore: %v", err)
+	}
+	return store
+}
+
+func TestBlobStorePutVerified(t *testing.T) {
+	store := newTestBlobStore(t)
+	defer os.RemoveAll(store.root)
+
+	content := []byte("plugin binary contents")
+	digest := Digest(content)
+
+	if err := store.PutVerified(digest, content); err != nil {
+		t.Fatalf("PutVerified with correct digest returned error: %v", err)
+	}
+	if !store.Has(digest) {
+		t.Fatalf("expected blob %s to be present after PutVerified", digest)
+	}
+	got, err := store.Get(digest)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if string(got) != string(content) {
+		t.Fatalf("Get returned %q, want %q", got, content)
+	}
+}
+
+func TestBlobStorePutVerifiedDigestMismatch(t *testing.T) {
+	store := newTestBlobStore(t)
+	defer os.RemoveAll(store.root)
+
+	content := []byte("plugin binary contents")
+	wrongDigest := Digest([]byte("something else entirely"))
+
+	err := store.PutVerified(wrongDigest, content)
+	if err == nil {
+		t.Fatal("expected PutVerified to reject content that doesn't match digest")
+	}
+	if store.Has(wrongDigest) {
+		t.Fatal("PutVerified must not write content that failed digest verification")
+	}
+}
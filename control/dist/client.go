@@ -0,0 +1,124 @@
+package dist
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// refPattern matches an OCI-style plugin reference, e.g.
+// "snap-collector-mock:1@sha256:abcd...".  The version and digest are both
+// optional so a reference may be resolved against the latest version known
+// to the registry. The name component excludes "/" so that a filesystem
+// path containing one, relative or absolute, never parses as a reference;
+// a bare file name with no "/" can still parse as one.
+var refPattern = regexp.MustCompile(`^([^:@/]+)(?::(\d+))?(?:@sha256:([0-9a-f]{64}))?$`)
+
+// Ref is a parsed plugin reference identifying a name, an optional
+// version, and an optional pinned digest.
+type Ref struct {
+	Name    string
+	Version int
+	Digest  string // hex sha256, empty if unpinned
+}
+
+// ParseRef parses an OCI-style plugin reference such as
+// "snap-collector-mock:1@sha256:<digest>".
+func ParseRef(ref string) (*Ref, error) {
+	m := refPattern.FindStringSubmatch(ref)
+	if m == nil {
+		return nil, fmt.Errorf("dist: invalid plugin reference %q", ref)
+	}
+
+	r := &Ref{Name: m[1], Version: -1, Digest: m[3]}
+	if m[2] != "" {
+		v, err := strconv.Atoi(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("dist: invalid plugin reference %q: %v", ref, err)
+		}
+		r.Version = v
+	}
+	return r, nil
+}
+
+func (r *Ref) String() string {
+	s := r.Name
+	if r.Version >= 0 {
+		s += ":" + strconv.Itoa(r.Version)
+	}
+	if r.Digest != "" {
+		s += "@sha256:" + r.Digest
+	}
+	return s
+}
+
+// Fetcher retrieves a plugin's manifest and binary layer from a remote
+// registry. Implementations know how to talk to a specific transport
+// (HTTP registry, local mirror, etc).
+type Fetcher interface {
+	// FetchManifest returns the raw PluginConfig manifest for ref.
+	FetchManifest(ref *Ref) ([]byte, error)
+	// FetchBinary returns the raw binary layer for ref, pinned to the
+	// digest named in the manifest.
+	FetchBinary(ref *Ref, digest string) ([]byte, error)
+}
+
+// Client pulls plugin manifests and binaries into a local BlobStore,
+// verifying every blob's digest before it is written to disk.
+type Client struct {
+	store   *BlobStore
+	fetcher Fetcher
+}
+
+// NewClient returns a distribution Client backed by store and fetching
+// blobs through fetcher.
+func NewClient(store *BlobStore, fetcher Fetcher) *Client {
+	return &Client{store: store, fetcher: fetcher}
+}
+
+// Pull resolves ref against the registry, verifies the manifest and
+// binary layer against their digests, and writes each blob (the manifest
+// itself, content-addressed by its own digest, and the binary layer,
+// content-addressed by the digest the manifest pins) into the blobstore,
+// so an operator can re-audit exactly what was pulled later. It returns
+// the verified manifest and the path at which the binary layer can be
+// found.
+func (c *Client) Pull(ref string) (*PluginConfig, string, error) {
+	r, err := ParseRef(ref)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rawManifest, err := c.fetcher.FetchManifest(r)
+	if err != nil {
+		return nil, "", fmt.Errorf("dist: fetching manifest for %s: %v", r, err)
+	}
+
+	cfg, err := ParsePluginConfig(rawManifest)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if cfg.Name != r.Name {
+		return nil, "", fmt.Errorf("dist: manifest name %q does not match requested reference %s", cfg.Name, r)
+	}
+
+	if r.Digest != "" && r.Digest != cfg.Digest() {
+		return nil, "", fmt.Errorf("dist: manifest digest sha256:%s does not match requested reference %s", cfg.Digest(), r)
+	}
+
+	if _, err := c.store.Put(rawManifest); err != nil {
+		return nil, "", fmt.Errorf("dist: storing manifest for %s: %v", r, err)
+	}
+
+	binary, err := c.fetcher.FetchBinary(r, cfg.Digest())
+	if err != nil {
+		return nil, "", fmt.Errorf("dist: fetching binary for %s: %v", r, err)
+	}
+
+	if err := c.store.PutVerified(cfg.Digest(), binary); err != nil {
+		return nil, "", err
+	}
+
+	return cfg, c.store.path(cfg.Digest()), nil
+}
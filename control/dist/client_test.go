@@ -0,0 +1,55 @@
+package dist
+
+import "testing"
+
+func TestParseRef(t *testing.T) {
+	digest := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	cases := []struct {
+		ref     string
+		name    string
+		version int
+		digest  string
+	}{
+		{"snap-collector-mock", "snap-collector-mock", -1, ""},
+		{"snap-collector-mock:1", "snap-collector-mock", 1, ""},
+		{"snap-collector-mock@sha256:" + digest, "snap-collector-mock", -1, digest},
+		{"snap-collector-mock:2@sha256:" + digest, "snap-collector-mock", 2, digest},
+	}
+
+	for _, c := range cases {
+		r, err := ParseRef(c.ref)
+		if err != nil {
+			t.Errorf("ParseRef(%q) returned error: %v", c.ref, err)
+			continue
+		}
+		if r.Name != c.name || r.Version != c.version || r.Digest != c.digest {
+			t.Errorf("ParseRef(%q) = %+v, want {Name:%s Version:%d Digest:%s}", c.ref, r, c.name, c.version, c.digest)
+		}
+	}
+}
+
+func TestParseRefInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"/opt/plugins/snap-collector-mock",
+		"./relative/path/to/plugin",
+		"snap-collector-mock:notanumber",
+		"snap-collector-mock@sha256:tooshort",
+	}
+
+	for _, ref := range cases {
+		if _, err := ParseRef(ref); err == nil {
+			t.Errorf("ParseRef(%q) expected error, got nil", ref)
+		}
+	}
+}
+
+func TestRefString(t *testing.T) {
+	digest := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	r := &Ref{Name: "snap-collector-mock", Version: 1, Digest: digest}
+	want := "snap-collector-mock:1@sha256:" + digest
+	if got := r.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
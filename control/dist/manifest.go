@@ -0,0 +1,54 @@
+package dist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// PluginConfig is the immutable manifest pinned to a distributed plugin. It
+// declares everything control needs to trust and run the plugin without
+// inspecting the binary itself: the digest of the binary layer, the metric
+// namespaces it collects or publishes, the schema used to validate its
+// config policy, and the privileges it requires on the host.
+type PluginConfig struct {
+	Name               string     `json:"name"`
+	Version            int        `json:"version"`
+	BinaryDigest       string     `json:"binary_digest"`
+	Namespaces         [][]string `json:"namespaces"`
+	ConfigPolicySchema string     `json:"config_policy_schema"`
+	RequiredPrivileges []string   `json:"required_privileges"`
+}
+
+// ParsePluginConfig unmarshals and validates a PluginConfig manifest.
+func ParsePluginConfig(data []byte) (*PluginConfig, error) {
+	cfg := &PluginConfig{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("dist: invalid plugin manifest: %v", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate confirms the manifest is well formed and pins a binary digest.
+func (c *PluginConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("dist: plugin manifest missing name")
+	}
+	if c.BinaryDigest == "" {
+		return errors.New("dist: plugin manifest missing binary_digest")
+	}
+	if !strings.HasPrefix(c.BinaryDigest, "sha256:") {
+		return fmt.Errorf("dist: plugin manifest binary_digest must be sha256:<digest>, got %s", c.BinaryDigest)
+	}
+	return nil
+}
+
+// Digest returns the manifest's pinned binary digest without the
+// "sha256:" scheme prefix.
+func (c *PluginConfig) Digest() string {
+	return strings.TrimPrefix(c.BinaryDigest, "sha256:")
+}
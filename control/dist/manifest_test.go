@@ -0,0 +1,43 @@
+package dist
+
+import "testing"
+
+const validManifest = `{
+	"name": "snap-collector-mock",
+	"version": 1,
+	"binary_digest": "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	"namespaces": [["intel", "mock", "foo"]],
+	"required_privileges": ["network"]
+}`
+
+func TestParsePluginConfig(t *testing.T) {
+	cfg, err := ParsePluginConfig([]byte(validManifest))
+	if err != nil {
+		t.Fatalf("ParsePluginConfig returned error: %v", err)
+	}
+	if cfg.Name != "snap-collector-mock" {
+		t.Errorf("Name = %q, want snap-collector-mock", cfg.Name)
+	}
+	if cfg.Version != 1 {
+		t.Errorf("Version = %d, want 1", cfg.Version)
+	}
+	want := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	if got := cfg.Digest(); got != want {
+		t.Errorf("Digest() = %q, want %q", got, want)
+	}
+}
+
+func TestParsePluginConfigErrors(t *testing.T) {
+	cases := map[string]string{
+		"invalid json":      `not json`,
+		"missing name":      `{"binary_digest": "sha256:abcd"}`,
+		"missing digest":    `{"name": "snap-collector-mock"}`,
+		"unprefixed digest": `{"name": "snap-collector-mock", "binary_digest": "abcd"}`,
+	}
+
+	for desc, raw := range cases {
+		if _, err := ParsePluginConfig([]byte(raw)); err == nil {
+			t.Errorf("%s: expected error, got nil", desc)
+		}
+	}
+}
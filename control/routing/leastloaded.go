@@ -0,0 +1,43 @@
+package routing
+
+import "errors"
+
+var errLeastLoadedNoCandidates = errors.New("routing: no candidates to select from")
+
+// Candidate is the minimal view of an available plugin instance that a
+// RoutingStrategy needs in order to make a selection. availablePlugin
+// (in package control) satisfies this structurally.
+type Candidate interface {
+	HitCount() int
+}
+
+// LeastLoaded selects the candidate with the fewest recorded hits so far,
+// so that retrying against "another available plugin from the same pool"
+// could steer away from an instance that just errored or timed out,
+// rather than risking a round-robin handing the retry straight back to
+// it.
+//
+// NOT currently wired in as a control.RoutingStrategy: that interface
+// (and the Select signature it requires, likely keyed off the pool's own
+// view of its candidates rather than a plain []Candidate) is declared in
+// a control package file outside this checkout, so whether *LeastLoaded
+// actually satisfies it can't be confirmed here. Wire it in via
+// pluginControl once that interface is visible and the signature below
+// can be checked against it.
+type LeastLoaded struct{}
+
+// Select returns the index of the least-loaded candidate.
+func (s *LeastLoaded) Select(candidates []Candidate) (int, error) {
+	best := -1
+	bestHits := 0
+	for i, c := range candidates {
+		if best == -1 || c.HitCount() < bestHits {
+			best = i
+			bestHits = c.HitCount()
+		}
+	}
+	if best == -1 {
+		return -1, errLeastLoadedNoCandidates
+	}
+	return best, nil
+}
@@ -0,0 +1,50 @@
+package routing
+
+import "testing"
+
+type fakeCandidate struct {
+	hits int
+}
+
+func (f *fakeCandidate) HitCount() int { return f.hits }
+
+func TestLeastLoadedSelect(t *testing.T) {
+	candidates := []Candidate{
+		&fakeCandidate{hits: 5},
+		&fakeCandidate{hits: 1},
+		&fakeCandidate{hits: 3},
+	}
+
+	s := &LeastLoaded{}
+	i, err := s.Select(candidates)
+	if err != nil {
+		t.Fatalf("Select returned error: %v", err)
+	}
+	if i != 1 {
+		t.Errorf("Select returned index %d, want 1 (the candidate with 1 hit)", i)
+	}
+}
+
+func TestLeastLoadedSelectTieBreaksToFirst(t *testing.T) {
+	candidates := []Candidate{
+		&fakeCandidate{hits: 2},
+		&fakeCandidate{hits: 2},
+	}
+
+	s := &LeastLoaded{}
+	i, err := s.Select(candidates)
+	if err != nil {
+		t.Fatalf("Select returned error: %v", err)
+	}
+	if i != 0 {
+		t.Errorf("Select returned index %d, want 0 (first candidate on a tie)", i)
+	}
+}
+
+func TestLeastLoadedSelectEmpty(t *testing.T) {
+	s := &LeastLoaded{}
+	_, err := s.Select(nil)
+	if err == nil {
+		t.Fatal("expected Select on an empty candidate list to return an error")
+	}
+}